@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/database"
+	cartgrpc "github.com/baimhons/nom-naa-shop.git/internal/grpc"
+	"github.com/baimhons/nom-naa-shop.git/internal/grpc/pb"
+	"github.com/baimhons/nom-naa-shop.git/internal/repositories"
+	"github.com/baimhons/nom-naa-shop.git/internal/services"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	db, err := database.Connect()
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	cartRepository := repositories.NewCartRepository(db)
+	snackRepository := repositories.NewSnackRepository(db)
+	itemRepository := repositories.NewItemRepository(db)
+	stockReservationRepository := repositories.NewStockReservationRepository(db)
+	cartPromotionRepository := repositories.NewCartPromotionRepository(db)
+	promotionRepository := repositories.NewPromotionRepository(db)
+	promotionRedemptionRepository := repositories.NewPromotionRedemptionRepository(db)
+	orderRepository := repositories.NewOrderRepository(db)
+	paymentRepository := repositories.NewPaymentRepository(db)
+
+	promotionService := services.NewPromotionService(promotionRepository, promotionRedemptionRepository)
+	orderService := services.NewOrderService(orderRepository, paymentRepository, snackRepository, db)
+	cartService := services.NewCartService(cartRepository, snackRepository, itemRepository, stockReservationRepository, cartPromotionRepository, promotionService, orderService, db)
+
+	port := os.Getenv("GRPC_PORT")
+	if port == "" {
+		port = "50051"
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	if err != nil {
+		log.Fatalf("failed to listen on port %s: %v", port, err)
+	}
+
+	server := grpc.NewServer()
+	pb.RegisterCartServiceServer(server, cartgrpc.NewCartServer(cartService))
+
+	log.Printf("gRPC server listening on :%s", port)
+	if err := server.Serve(lis); err != nil {
+		log.Fatalf("failed to serve gRPC: %v", err)
+	}
+}
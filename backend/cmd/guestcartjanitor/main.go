@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/database"
+	"github.com/baimhons/nom-naa-shop.git/internal/jobs"
+)
+
+func main() {
+	db, err := database.Connect()
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	ttl := jobs.DefaultGuestCartTTL
+	if days := os.Getenv("GUEST_CART_TTL_DAYS"); days != "" {
+		parsed, err := strconv.Atoi(days)
+		if err != nil {
+			log.Fatalf("invalid GUEST_CART_TTL_DAYS %q: %v", days, err)
+		}
+		ttl = time.Duration(parsed) * 24 * time.Hour
+	}
+
+	interval := 1 * time.Hour
+	if every := os.Getenv("GUEST_CART_PRUNE_INTERVAL"); every != "" {
+		parsed, err := time.ParseDuration(every)
+		if err != nil {
+			log.Fatalf("invalid GUEST_CART_PRUNE_INTERVAL %q: %v", every, err)
+		}
+		interval = parsed
+	}
+
+	ctx := context.Background()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := jobs.PruneGuestCarts(ctx, db, ttl)
+		if err != nil {
+			log.Printf("guest cart prune failed: %v", err)
+		} else if pruned > 0 {
+			log.Printf("pruned %d guest cart(s) untouched for longer than %s", pruned, ttl)
+		}
+
+		<-ticker.C
+	}
+}
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/dtos/request"
+	"github.com/baimhons/nom-naa-shop.git/internal/grpc/pb"
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/baimhons/nom-naa-shop.git/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CartServer is a thin gRPC adapter over CartServiceImpl: it translates
+// protobuf messages to/from the dtos/models the HTTP handlers already use
+// and maps the service's (int, error) return shape onto a gRPC status, so
+// the transaction/validation logic keeps living in one place.
+type CartServer struct {
+	pb.UnimplementedCartServiceServer
+	cartService services.CartService
+}
+
+func NewCartServer(cartService services.CartService) *CartServer {
+	return &CartServer{cartService: cartService}
+}
+
+func (s *CartServer) AddItemToCart(ctx context.Context, in *pb.AddItemToCartRequest) (*pb.CartResponse, error) {
+	snackID, err := uuid.Parse(in.SnackId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid snack_id")
+	}
+
+	cart, code, err := s.cartService.AddItemToCart(ctx, request.AddItemToCartRequest{
+		SnackID:  snackID,
+		Quantity: int(in.Quantity),
+	}, models.UserContext{ID: in.UserId})
+	if err != nil {
+		return nil, toStatusError(code, err)
+	}
+
+	return toCartResponse(cart), nil
+}
+
+func (s *CartServer) GetCartByID(ctx context.Context, in *pb.GetCartByIDRequest) (*pb.CartResponse, error) {
+	cartID, err := uuid.Parse(in.CartId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cart_id")
+	}
+
+	breakdown, code, err := s.cartService.GetCartByID(ctx, cartID)
+	if err != nil {
+		return nil, toStatusError(code, err)
+	}
+
+	resp := toCartResponse(breakdown.Cart)
+	resp.Subtotal = breakdown.Subtotal
+	resp.Total = breakdown.Total
+	for _, discount := range breakdown.Discounts {
+		resp.Discounts = append(resp.Discounts, &pb.Discount{
+			PromotionId: discount.PromotionID,
+			Code:        discount.Code,
+			Type:        discount.Type,
+			Amount:      discount.Amount,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *CartServer) UpdateItemFromCart(ctx context.Context, in *pb.UpdateItemFromCartRequest) (*pb.CartResponse, error) {
+	itemID, err := uuid.Parse(in.ItemId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid item_id")
+	}
+
+	cart, code, err := s.cartService.UpdateItemFromCart(ctx, request.UpdateItemFromCartRequest{
+		ItemID:   itemID,
+		Quantity: int(in.Quantity),
+	}, models.UserContext{ID: in.UserId})
+	if err != nil {
+		return nil, toStatusError(code, err)
+	}
+
+	return toCartResponse(cart), nil
+}
+
+func (s *CartServer) DeleteItemFromCart(ctx context.Context, in *pb.DeleteItemFromCartRequest) (*pb.CartResponse, error) {
+	itemID, err := uuid.Parse(in.ItemId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid item_id")
+	}
+
+	cart, code, err := s.cartService.DeleteItemFromCart(ctx, itemID, models.UserContext{ID: in.UserId})
+	if err != nil {
+		return nil, toStatusError(code, err)
+	}
+
+	return toCartResponse(cart), nil
+}
+
+func (s *CartServer) ConfirmCart(ctx context.Context, in *pb.ConfirmCartRequest) (*pb.CartResponse, error) {
+	cartID, err := uuid.Parse(in.CartId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cart_id")
+	}
+
+	result, code, err := s.cartService.ConfirmCart(ctx, cartID, models.UserContext{ID: in.UserId})
+	if err != nil {
+		return nil, toStatusError(code, err)
+	}
+
+	return toCartResponse(result.Cart), nil
+}
+
+// toStatusError maps the HTTP-flavoured (fiber.StatusX, error) pairs the
+// service layer already returns onto the closest gRPC status code, so
+// handlers don't need a second copy of this logic.
+func toStatusError(code int, err error) error {
+	switch code {
+	case fiber.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case fiber.StatusForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case fiber.StatusNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case fiber.StatusConflict:
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toCartResponse(cart *models.Cart) *pb.CartResponse {
+	if cart == nil {
+		return nil
+	}
+
+	items := make([]*pb.Item, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, &pb.Item{
+			Id:       item.ID.String(),
+			SnackId:  item.SnackID.String(),
+			Quantity: int32(item.Quantity),
+		})
+	}
+
+	return &pb.CartResponse{
+		Id:        cart.ID.String(),
+		UserId:    cart.UserID.String(),
+		Status:    cart.Status,
+		Items:     items,
+		CreatedAt: timestamppb.New(cart.CreatedAt),
+	}
+}
@@ -0,0 +1,146 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/dtos/request"
+	cartgrpc "github.com/baimhons/nom-naa-shop.git/internal/grpc"
+	"github.com/baimhons/nom-naa-shop.git/internal/grpc/pb"
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+type fakeCartService struct {
+	cart      *models.Cart
+	breakdown *models.CartBreakdown
+	code      int
+	err       error
+}
+
+func (f *fakeCartService) AddItemToCart(ctx context.Context, req request.AddItemToCartRequest, userContext models.UserContext) (*models.Cart, int, error) {
+	return f.cart, f.code, f.err
+}
+
+func (f *fakeCartService) GetCartByID(ctx context.Context, id uuid.UUID) (*models.CartBreakdown, int, error) {
+	if f.breakdown != nil {
+		return f.breakdown, f.code, f.err
+	}
+	return &models.CartBreakdown{Cart: f.cart}, f.code, f.err
+}
+
+func (f *fakeCartService) UpdateItemFromCart(ctx context.Context, req request.UpdateItemFromCartRequest, userContext models.UserContext) (*models.Cart, int, error) {
+	return f.cart, f.code, f.err
+}
+
+func (f *fakeCartService) ConfirmCart(ctx context.Context, cartID uuid.UUID, userContext models.UserContext) (*models.ConfirmCartResult, int, error) {
+	return &models.ConfirmCartResult{Cart: f.cart}, f.code, f.err
+}
+
+func (f *fakeCartService) DeleteItemFromCart(ctx context.Context, itemID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error) {
+	return f.cart, f.code, f.err
+}
+
+func (f *fakeCartService) ApplyPromotion(ctx context.Context, cartID uuid.UUID, code string, userContext models.UserContext) (*models.CartBreakdown, int, error) {
+	return f.breakdown, f.code, f.err
+}
+
+func (f *fakeCartService) RemovePromotion(ctx context.Context, cartID uuid.UUID, code string, userContext models.UserContext) (*models.CartBreakdown, int, error) {
+	return f.breakdown, f.code, f.err
+}
+
+func (f *fakeCartService) MergeGuestCart(ctx context.Context, guestToken string, userContext models.UserContext) (*models.Cart, int, error) {
+	return f.cart, f.code, f.err
+}
+
+func dialer(t *testing.T, svc *fakeCartService) func(context.Context, string) (net.Conn, error) {
+	listener := bufconn.Listen(bufSize)
+
+	server := grpc.NewServer()
+	pb.RegisterCartServiceServer(server, cartgrpc.NewCartServer(svc))
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			t.Logf("bufconn server stopped: %v", err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+
+	return func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.Dial()
+	}
+}
+
+func newTestClient(t *testing.T, svc *fakeCartService) pb.CartServiceClient {
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(dialer(t, svc)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewCartServiceClient(conn)
+}
+
+func TestCartServer_GetCartByID_OK(t *testing.T) {
+	cartID := uuid.New()
+	userID := uuid.New()
+
+	client := newTestClient(t, &fakeCartService{
+		cart: &models.Cart{ID: cartID, UserID: userID, Status: "pending"},
+		code: fiber.StatusOK,
+	})
+
+	resp, err := client.GetCartByID(context.Background(), &pb.GetCartByIDRequest{CartId: cartID.String()})
+	if err != nil {
+		t.Fatalf("GetCartByID returned error: %v", err)
+	}
+
+	if resp.Id != cartID.String() {
+		t.Errorf("expected cart id %s, got %s", cartID, resp.Id)
+	}
+	if resp.Status != "pending" {
+		t.Errorf("expected status pending, got %s", resp.Status)
+	}
+}
+
+func TestCartServer_GetCartByID_NotFound(t *testing.T) {
+	client := newTestClient(t, &fakeCartService{
+		code: fiber.StatusNotFound,
+		err:  errors.New("cart not found"),
+	})
+
+	_, err := client.GetCartByID(context.Background(), &pb.GetCartByIDRequest{CartId: uuid.New().String()})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("expected a gRPC status error, got %v", err)
+	}
+	if st.Message() != "cart not found" {
+		t.Errorf("expected message %q, got %q", "cart not found", st.Message())
+	}
+}
+
+func TestCartServer_GetCartByID_InvalidID(t *testing.T) {
+	client := newTestClient(t, &fakeCartService{})
+
+	_, err := client.GetCartByID(context.Background(), &pb.GetCartByIDRequest{CartId: "not-a-uuid"})
+	if err == nil {
+		t.Fatal("expected error for invalid cart id, got nil")
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// paymentWebhookPayload is the shape we expect from an external payment
+// provider's webhook. Real providers sign the request body; verifying that
+// signature belongs here too once a provider is chosen, ahead of parsing.
+type paymentWebhookPayload struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	Event          string `json:"event"`
+}
+
+const paymentWebhookEventSucceeded = "payment.succeeded"
+
+type PaymentWebhookHandler struct {
+	orderService services.OrderService
+}
+
+func NewPaymentWebhookHandler(orderService services.OrderService) *PaymentWebhookHandler {
+	return &PaymentWebhookHandler{orderService: orderService}
+}
+
+// Handle drives the order state machine from a payment provider webhook.
+// Lookup is keyed on the provider's idempotency key, so a duplicate or
+// out-of-order delivery fails the underlying conditional status update
+// instead of double-processing the order.
+func (h *PaymentWebhookHandler) Handle(c *fiber.Ctx) error {
+	var payload paymentWebhookPayload
+	if err := c.BodyParser(&payload); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid webhook payload"})
+	}
+
+	if payload.IdempotencyKey == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "idempotency_key is required"})
+	}
+
+	switch payload.Event {
+	case paymentWebhookEventSucceeded:
+		order, err := h.orderService.MarkPaid(c.Context(), payload.IdempotencyKey)
+		if err != nil {
+			if errors.Is(err, services.ErrInvalidOrderTransition) {
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusOK).JSON(order)
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "unsupported webhook event: " + payload.Event})
+	}
+}
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultGuestCartTTL is how long a guest cart can sit untouched before
+// PruneGuestCarts considers it abandoned.
+const DefaultGuestCartTTL = 7 * 24 * time.Hour
+
+// PruneGuestCarts deletes pending guest carts (identified by a non-empty
+// guest_token) that haven't been updated within ttl, along with their items
+// and stock reservations, since neither has an ON DELETE CASCADE and would
+// otherwise leak. It's meant to be driven by a scheduler, not called
+// per-request, so it takes a *gorm.DB directly rather than going through
+// CartService.
+//
+// The cart row is deleted first, in the one conditional statement the
+// pruner has always used: Postgres re-checks the WHERE clause against each
+// row as the statement runs, so a cart a concurrent request just touched no
+// longer matches and is left alone. The RETURNING ids tell us exactly which
+// carts actually went away, and child rows are cascaded only for those —
+// never for a cart that survived the prune.
+func PruneGuestCarts(ctx context.Context, db *gorm.DB, ttl time.Duration) (int64, error) {
+	cutoff := time.Now().Add(-ttl)
+
+	tx := db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	var deletedCarts []models.Cart
+	result := tx.Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("status = ? AND guest_token <> ? AND updated_at < ?", "pending", "", cutoff).
+		Delete(&deletedCarts)
+	if result.Error != nil {
+		tx.Rollback()
+		return 0, result.Error
+	}
+	if len(deletedCarts) == 0 {
+		tx.Rollback()
+		return 0, nil
+	}
+
+	cartIDs := make([]uuid.UUID, 0, len(deletedCarts))
+	for _, cart := range deletedCarts {
+		cartIDs = append(cartIDs, cart.ID)
+	}
+
+	if err := tx.Where("cart_id IN ?", cartIDs).Delete(&models.StockReservation{}).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Where("cart_id IN ?", cartIDs).Delete(&models.Item{}).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
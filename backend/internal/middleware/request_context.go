@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type contextKey string
+
+const requestIDKey contextKey = "request_id"
+
+const localsContextKey = "ctx"
+
+// RequestContext stamps every request with a trace/request ID and stores a
+// context.Context carrying it in fiber.Locals, so handlers can pull a real
+// context.Context out of c and thread it into the service layer. The same ID
+// is echoed back on the response, and RequestIDFromContext reads it back out
+// of the context. No SQL logger reads the ID, so repository SQL logs do not
+// carry it.
+func RequestContext() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requestID := c.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		ctx := context.WithValue(c.Context(), requestIDKey, requestID)
+		c.Locals(localsContextKey, ctx)
+		c.Set("X-Request-ID", requestID)
+
+		return c.Next()
+	}
+}
+
+// FromFiber returns the context.Context stashed by RequestContext, falling
+// back to the fasthttp request context (which carries no request ID) if the
+// middleware wasn't mounted, e.g. in handler unit tests.
+func FromFiber(c *fiber.Ctx) context.Context {
+	if ctx, ok := c.Locals(localsContextKey).(context.Context); ok {
+		return ctx
+	}
+	return c.Context()
+}
+
+// RequestIDFromContext returns the request ID stashed by RequestContext, or
+// "" if ctx didn't come from a request RequestContext handled.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
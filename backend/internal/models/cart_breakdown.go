@@ -0,0 +1,19 @@
+package models
+
+// PromotionDiscount is the amount a single applied promotion took off a
+// cart's subtotal, reported alongside the promotion it came from.
+type PromotionDiscount struct {
+	PromotionID string  `json:"promotion_id"`
+	Code        string  `json:"code"`
+	Type        string  `json:"type"`
+	Amount      float64 `json:"amount"`
+}
+
+// CartBreakdown is the priced view of a cart: its items plus the subtotal,
+// the discount each applied promotion contributed, and the resulting total.
+type CartBreakdown struct {
+	Cart      *Cart               `json:"cart"`
+	Subtotal  float64             `json:"subtotal"`
+	Discounts []PromotionDiscount `json:"discounts"`
+	Total     float64             `json:"total"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CartPromotion joins a pending cart to a promotion it has applied. A cart
+// may hold at most one cart-level promotion and one FREE_SHIPPING
+// promotion; that stacking rule is enforced by CartService, not the schema.
+type CartPromotion struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	CartID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cart_promotions_cart_promotion" json:"cart_id"`
+	PromotionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cart_promotions_cart_promotion" json:"promotion_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
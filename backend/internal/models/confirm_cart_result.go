@@ -0,0 +1,10 @@
+package models
+
+// ConfirmCartResult is what ConfirmCart hands back once a cart has been
+// turned into an order: the confirmed cart, the frozen order, and the
+// payment intent the client should use to collect payment.
+type ConfirmCartResult struct {
+	Cart          *Cart    `json:"cart"`
+	Order         *Order   `json:"order"`
+	PaymentIntent *Payment `json:"payment_intent"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderStatus string
+
+const (
+	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPaid           OrderStatus = "PAID"
+	OrderStatusFulfilled      OrderStatus = "FULFILLED"
+	OrderStatusCompleted      OrderStatus = "COMPLETED"
+	OrderStatusCancelled      OrderStatus = "CANCELLED"
+	OrderStatusRefunded       OrderStatus = "REFUNDED"
+)
+
+// orderTransitions lists the statuses each status is allowed to move to.
+// Anything not listed here (e.g. COMPLETED -> PAID, or re-cancelling a
+// CANCELLED order) is rejected by OrderService as an invalid transition.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPendingPayment: {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:           {OrderStatusFulfilled, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusFulfilled:      {OrderStatusCompleted, OrderStatusRefunded},
+	OrderStatusCompleted:      {OrderStatusRefunded},
+}
+
+// CanTransitionTo reports whether moving from this status to next is a
+// legal state machine transition.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Order is frozen from the cart at confirmation time: line-item prices and
+// quantities are copied onto OrderItem so later Snack price changes or cart
+// edits (the cart itself stays behind, marked confirmed) don't rewrite order
+// history.
+type Order struct {
+	ID        uuid.UUID   `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	CartID    uuid.UUID   `gorm:"type:uuid;not null;index" json:"cart_id"`
+	UserID    uuid.UUID   `gorm:"type:uuid;not null;index" json:"user_id"`
+	Status    OrderStatus `gorm:"not null" json:"status"`
+	Items     []OrderItem `gorm:"foreignKey:OrderID" json:"items"`
+	Subtotal  float64     `gorm:"not null" json:"subtotal"`
+	Discount  float64     `gorm:"not null" json:"discount"`
+	Total     float64     `gorm:"not null" json:"total"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+type OrderItem struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	OrderID   uuid.UUID `gorm:"type:uuid;not null;index" json:"order_id"`
+	SnackID   uuid.UUID `gorm:"type:uuid;not null" json:"snack_id"`
+	Quantity  int       `gorm:"not null" json:"quantity"`
+	UnitPrice float64   `gorm:"not null" json:"unit_price"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending   PaymentStatus = "PENDING"
+	PaymentStatusSucceeded PaymentStatus = "SUCCEEDED"
+	PaymentStatusFailed    PaymentStatus = "FAILED"
+	PaymentStatusRefunded  PaymentStatus = "REFUNDED"
+)
+
+// Payment is the intent/record for a single attempt to pay for an Order.
+// IdempotencyKey is what an external payment provider's webhook uses to
+// look the payment back up, so retried webhook deliveries don't drive the
+// order's state machine twice.
+type Payment struct {
+	ID             uuid.UUID     `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	OrderID        uuid.UUID     `gorm:"type:uuid;not null;index" json:"order_id"`
+	Provider       string        `gorm:"not null" json:"provider"`
+	IdempotencyKey string        `gorm:"uniqueIndex;not null" json:"idempotency_key"`
+	Amount         float64       `gorm:"not null" json:"amount"`
+	Status         PaymentStatus `gorm:"not null" json:"status"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PromotionType string
+
+const (
+	PromotionTypePercentOff   PromotionType = "PERCENT_OFF"
+	PromotionTypeFixedOff     PromotionType = "FIXED_OFF"
+	PromotionTypeBuyXGetY     PromotionType = "BUY_X_GET_Y"
+	PromotionTypeFreeShipping PromotionType = "FREE_SHIPPING"
+)
+
+// Promotion is a coupon definition. Value is interpreted according to Type:
+// a percentage for PERCENT_OFF, a currency amount for FIXED_OFF, and
+// ignored for FREE_SHIPPING/BUY_X_GET_Y. BUY_X_GET_Y instead uses
+// BuyQuantity/GetQuantity: for every BuyQuantity+GetQuantity units in the
+// cart, the cheapest GetQuantity of them are free.
+type Promotion struct {
+	ID             uuid.UUID     `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	Code           string        `gorm:"uniqueIndex;not null" json:"code"`
+	Type           PromotionType `gorm:"not null" json:"type"`
+	Value          float64       `gorm:"not null" json:"value"`
+	BuyQuantity    int           `json:"buy_quantity"`
+	GetQuantity    int           `json:"get_quantity"`
+	MinSubtotal    float64       `json:"min_subtotal"`
+	StartAt        time.Time     `json:"start_at"`
+	EndAt          time.Time     `json:"end_at"`
+	MaxRedemptions int           `json:"max_redemptions"`
+	PerUserLimit   int           `json:"per_user_limit"`
+	TimesRedeemed  int           `gorm:"not null;default:0" json:"times_redeemed"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// IsActive reports whether the promotion is within its date window and
+// still has redemptions left. MaxRedemptions of 0 means unlimited.
+func (p Promotion) IsActive(now time.Time) bool {
+	if !p.StartAt.IsZero() && now.Before(p.StartAt) {
+		return false
+	}
+	if !p.EndAt.IsZero() && now.After(p.EndAt) {
+		return false
+	}
+	return p.MaxRedemptions == 0 || p.TimesRedeemed < p.MaxRedemptions
+}
+
+// IsCartLevel reports whether this promotion competes for the single
+// cart-level discount slot, as opposed to the separate shipping slot.
+func (p Promotion) IsCartLevel() bool {
+	return p.Type != PromotionTypeFreeShipping
+}
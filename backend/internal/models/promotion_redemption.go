@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PromotionRedemption records one user's confirmed use of a promotion (one
+// row per ConfirmCart that redeemed it), so Promotion.PerUserLimit can be
+// enforced by counting a user's own rows instead of trusting a counter that
+// isn't scoped to them.
+type PromotionRedemption struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	PromotionID uuid.UUID `gorm:"type:uuid;not null;index:idx_promotion_redemptions_promotion_user" json:"promotion_id"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index:idx_promotion_redemptions_promotion_user" json:"user_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StockReservation is a soft, time-boxed hold on stock for an item sitting
+// in a pending cart. It exists purely to keep other shoppers informed
+// (e.g. "3 left, 2 reserved") — it does not itself decrement Snack.Quantity;
+// that only happens atomically when the cart is confirmed.
+type StockReservation struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	CartID    uuid.UUID `gorm:"type:uuid;not null;index:idx_stock_reservations_cart_snack,unique" json:"cart_id"`
+	SnackID   uuid.UUID `gorm:"type:uuid;not null;index:idx_stock_reservations_cart_snack,unique" json:"snack_id"`
+	Quantity  int       `gorm:"not null" json:"quantity"`
+	ExpiresAt time.Time `gorm:"not null;index" json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
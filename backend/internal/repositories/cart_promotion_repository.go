@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CartPromotionRepository interface {
+	Attach(ctx context.Context, cartID, promotionID uuid.UUID) error
+	Detach(ctx context.Context, cartID, promotionID uuid.UUID) error
+	ListPromotionsByCartID(ctx context.Context, cartID uuid.UUID) ([]models.Promotion, error)
+}
+
+type CartPromotionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCartPromotionRepository(db *gorm.DB) *CartPromotionRepositoryImpl {
+	return &CartPromotionRepositoryImpl{db: db}
+}
+
+func (r *CartPromotionRepositoryImpl) Attach(ctx context.Context, cartID, promotionID uuid.UUID) error {
+	return r.db.WithContext(ctx).Create(&models.CartPromotion{
+		CartID:      cartID,
+		PromotionID: promotionID,
+	}).Error
+}
+
+func (r *CartPromotionRepositoryImpl) Detach(ctx context.Context, cartID, promotionID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("cart_id = ? AND promotion_id = ?", cartID, promotionID).
+		Delete(&models.CartPromotion{}).Error
+}
+
+func (r *CartPromotionRepositoryImpl) ListPromotionsByCartID(ctx context.Context, cartID uuid.UUID) ([]models.Promotion, error) {
+	var promotions []models.Promotion
+	err := r.db.WithContext(ctx).
+		Joins("JOIN cart_promotions ON cart_promotions.promotion_id = promotions.id").
+		Where("cart_promotions.cart_id = ?", cartID).
+		Order("cart_promotions.created_at").
+		Find(&promotions).Error
+	return promotions, err
+}
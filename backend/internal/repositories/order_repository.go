@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrderRepository interface {
+	CreateWithTx(tx *gorm.DB, order *models.Order) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	// UpdateStatusWithTx moves the order to next only if it is still in
+	// from, so a stale read can't drive the state machine backwards.
+	UpdateStatusWithTx(tx *gorm.DB, orderID uuid.UUID, from, next models.OrderStatus) (bool, error)
+}
+
+type OrderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) *OrderRepositoryImpl {
+	return &OrderRepositoryImpl{db: db}
+}
+
+func (r *OrderRepositoryImpl) CreateWithTx(tx *gorm.DB, order *models.Order) error {
+	return tx.Create(order).Error
+}
+
+func (r *OrderRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	if err := r.db.WithContext(ctx).Preload("Items").Where("id = ?", id).First(&order).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *OrderRepositoryImpl) UpdateStatusWithTx(tx *gorm.DB, orderID uuid.UUID, from, next models.OrderStatus) (bool, error) {
+	result := tx.Model(&models.Order{}).
+		Where("id = ? AND status = ?", orderID, from).
+		Update("status", next)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
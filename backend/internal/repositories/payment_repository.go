@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PaymentRepository interface {
+	CreateWithTx(tx *gorm.DB, payment *models.Payment) error
+	GetByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.Payment, error)
+	UpdateStatus(ctx context.Context, paymentID uuid.UUID, status models.PaymentStatus) error
+}
+
+type PaymentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) *PaymentRepositoryImpl {
+	return &PaymentRepositoryImpl{db: db}
+}
+
+func (r *PaymentRepositoryImpl) CreateWithTx(tx *gorm.DB, payment *models.Payment) error {
+	return tx.Create(payment).Error
+}
+
+func (r *PaymentRepositoryImpl) GetByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.Payment, error) {
+	var payment models.Payment
+	if err := r.db.WithContext(ctx).Where("idempotency_key = ?", idempotencyKey).First(&payment).Error; err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *PaymentRepositoryImpl) UpdateStatus(ctx context.Context, paymentID uuid.UUID, status models.PaymentStatus) error {
+	return r.db.WithContext(ctx).Model(&models.Payment{}).Where("id = ?", paymentID).Update("status", status).Error
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PromotionRedemptionRepository interface {
+	// CountByUser reports how many times userID has already redeemed
+	// promotionID, so PromotionService can check it against PerUserLimit
+	// before a code is applied.
+	CountByUser(ctx context.Context, promotionID, userID uuid.UUID) (int, error)
+	// CountByUserWithTx is CountByUser run on tx, so RedeemAllWithTx can
+	// re-check the limit inside the same transaction it redeems in.
+	CountByUserWithTx(tx *gorm.DB, promotionID, userID uuid.UUID) (int, error)
+	// RecordWithTx writes a redemption on tx, the same transaction the
+	// caller is redeeming the promotion and decrementing stock in.
+	RecordWithTx(tx *gorm.DB, promotionID, userID uuid.UUID) error
+}
+
+type PromotionRedemptionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPromotionRedemptionRepository(db *gorm.DB) *PromotionRedemptionRepositoryImpl {
+	return &PromotionRedemptionRepositoryImpl{db: db}
+}
+
+func (r *PromotionRedemptionRepositoryImpl) CountByUser(ctx context.Context, promotionID, userID uuid.UUID) (int, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.PromotionRedemption{}).
+		Where("promotion_id = ? AND user_id = ?", promotionID, userID).
+		Count(&count).Error
+	return int(count), err
+}
+
+func (r *PromotionRedemptionRepositoryImpl) CountByUserWithTx(tx *gorm.DB, promotionID, userID uuid.UUID) (int, error) {
+	var count int64
+	err := tx.Model(&models.PromotionRedemption{}).
+		Where("promotion_id = ? AND user_id = ?", promotionID, userID).
+		Count(&count).Error
+	return int(count), err
+}
+
+func (r *PromotionRedemptionRepositoryImpl) RecordWithTx(tx *gorm.DB, promotionID, userID uuid.UUID) error {
+	return tx.Create(&models.PromotionRedemption{
+		PromotionID: promotionID,
+		UserID:      userID,
+	}).Error
+}
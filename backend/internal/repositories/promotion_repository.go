@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PromotionRepository interface {
+	GetByCode(ctx context.Context, code string) (*models.Promotion, error)
+	// RedeemWithTx atomically increments TimesRedeemed on tx, the same
+	// transaction the caller is decrementing stock in, and fails with
+	// ErrRedemptionCapExceeded if another request already claimed the last slot.
+	RedeemWithTx(tx *gorm.DB, promotionID uuid.UUID) error
+}
+
+var ErrRedemptionCapExceeded = errors.New("promotion redemption cap exceeded")
+
+type PromotionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPromotionRepository(db *gorm.DB) *PromotionRepositoryImpl {
+	return &PromotionRepositoryImpl{db: db}
+}
+
+func (r *PromotionRepositoryImpl) GetByCode(ctx context.Context, code string) (*models.Promotion, error) {
+	var promotion models.Promotion
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&promotion).Error; err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func (r *PromotionRepositoryImpl) RedeemWithTx(tx *gorm.DB, promotionID uuid.UUID) error {
+	result := tx.Model(&models.Promotion{}).
+		Where("id = ? AND (max_redemptions = 0 OR times_redeemed < max_redemptions)", promotionID).
+		Update("times_redeemed", gorm.Expr("times_redeemed + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		return fmt.Errorf("%w: %s", ErrRedemptionCapExceeded, promotionID)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StockReservationRepository interface {
+	Reserve(ctx context.Context, cartID, snackID uuid.UUID, quantity int, ttl time.Duration) error
+	DeleteByCartID(ctx context.Context, cartID uuid.UUID) error
+	// SumReservedQuantity totals the unexpired stock held for snackID by
+	// every cart other than excludeCartID, so a caller can subtract it from
+	// Snack.Quantity to get what's actually available to a new shopper.
+	SumReservedQuantity(ctx context.Context, snackID, excludeCartID uuid.UUID) (int, error)
+}
+
+type StockReservationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStockReservationRepository(db *gorm.DB) *StockReservationRepositoryImpl {
+	return &StockReservationRepositoryImpl{db: db}
+}
+
+// Reserve upserts the (cart, snack) reservation so repeated AddItemToCart
+// calls for the same item refresh the quantity and TTL instead of piling up
+// duplicate rows.
+func (r *StockReservationRepositoryImpl) Reserve(ctx context.Context, cartID, snackID uuid.UUID, quantity int, ttl time.Duration) error {
+	reservation := models.StockReservation{
+		CartID:    cartID,
+		SnackID:   snackID,
+		Quantity:  quantity,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cart_id"}, {Name: "snack_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity", "expires_at", "updated_at"}),
+	}).Create(&reservation).Error
+}
+
+func (r *StockReservationRepositoryImpl) DeleteByCartID(ctx context.Context, cartID uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("cart_id = ?", cartID).Delete(&models.StockReservation{}).Error
+}
+
+func (r *StockReservationRepositoryImpl) SumReservedQuantity(ctx context.Context, snackID, excludeCartID uuid.UUID) (int, error) {
+	var total int64
+	err := r.db.WithContext(ctx).
+		Model(&models.StockReservation{}).
+		Where("snack_id = ? AND cart_id <> ? AND expires_at > ?", snackID, excludeCartID, time.Now()).
+		Select("COALESCE(SUM(quantity), 0)").
+		Scan(&total).Error
+	return int(total), err
+}
@@ -1,198 +1,601 @@
-package services
-
-import (
-	"errors"
-
-	"github.com/baimhons/nom-naa-shop.git/internal/dtos/request"
-	"github.com/baimhons/nom-naa-shop.git/internal/models"
-	"github.com/baimhons/nom-naa-shop.git/internal/repositories"
-	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
-	"gorm.io/gorm"
-)
-
-type CartService interface {
-	AddItemToCart(req request.AddItemToCartRequest, userContext models.UserContext) (*models.Cart, int, error)
-	GetCartByID(id uuid.UUID) (*models.Cart, int, error)
-	UpdateItemFromCart(req request.UpdateItemFromCartRequest, userContext models.UserContext) (*models.Cart, int, error)
-	ConfirmCart(cartID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error)
-	DeleteItemFromCart(itemID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error)
-}
-
-type CartServiceImpl struct {
-	cartRepository  repositories.CartRepository
-	snackRepository repositories.SnackRepository
-	itemRepository  repositories.ItemRepository
-	db              *gorm.DB
-}
-
-func NewCartService(cartRepository repositories.CartRepository, snackRepository repositories.SnackRepository, itemRepository repositories.ItemRepository, db *gorm.DB) *CartServiceImpl {
-	return &CartServiceImpl{
-		cartRepository:  cartRepository,
-		snackRepository: snackRepository,
-		itemRepository:  itemRepository,
-		db:              db,
-	}
-}
-
-func (s *CartServiceImpl) AddItemToCart(req request.AddItemToCartRequest, userContext models.UserContext) (*models.Cart, int, error) {
-	cart, err := s.cartRepository.GetCartByCondition("user_id = ? AND status = ?", userContext.ID, "pending")
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("cart not found")
-	}
-
-	snack, err := s.snackRepository.GetSnackByID(req.SnackID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("snack not found")
-	}
-
-	if snack.Quantity < req.Quantity {
-		return nil, fiber.StatusBadRequest, errors.New("stock not enough")
-	}
-
-	isExist := false
-	var existingItem *models.Item
-
-	for i := range cart.Items {
-		if cart.Items[i].SnackID == req.SnackID {
-			isExist = true
-			existingItem = &cart.Items[i]
-			break
-		}
-	}
-
-	if isExist {
-		existingItem.Quantity += req.Quantity
-		if err := s.itemRepository.Update(existingItem); err != nil {
-			return nil, fiber.StatusInternalServerError, errors.New("failed to update item: " + err.Error())
-		}
-	} else {
-		newItem := models.Item{
-			SnackID:  req.SnackID,
-			Quantity: req.Quantity,
-			CartID:   cart.ID,
-		}
-
-		if err := s.itemRepository.Update(&newItem); err != nil {
-			return nil, fiber.StatusInternalServerError, errors.New("failed to create item: " + err.Error())
-		}
-	}
-
-	updatedCart, err := s.cartRepository.GetCartByID(cart.ID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("failed to fetch updated cart: " + err.Error())
-	}
-
-	return updatedCart, fiber.StatusOK, nil
-}
-
-func (s *CartServiceImpl) GetCartByID(id uuid.UUID) (*models.Cart, int, error) {
-	cart, err := s.cartRepository.GetCartByCondition("user_id = ? AND status = ?", id, "pending")
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fiber.StatusNotFound, errors.New("cart not found")
-		}
-		return nil, fiber.StatusInternalServerError, err
-	}
-
-	for i := range cart.Items {
-		var snack models.Snack
-		if err := s.db.Where("id = ?", cart.Items[i].SnackID).First(&snack).Error; err != nil {
-			return nil, fiber.StatusInternalServerError, err
-		}
-		cart.Items[i].Snack = snack
-	}
-
-	return cart, fiber.StatusOK, nil
-}
-
-func (s *CartServiceImpl) UpdateItemFromCart(req request.UpdateItemFromCartRequest, userContext models.UserContext) (*models.Cart, int, error) {
-	cart, err := s.cartRepository.GetCartByCondition("user_id = ? AND status = ?", userContext.ID, "pending")
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("cart not found")
-	}
-
-	item, err := s.itemRepository.GetItemByID(req.ItemID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("item not found")
-	}
-
-	snack, err := s.snackRepository.GetSnackByID(item.SnackID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("snack not found")
-	}
-
-	if snack.Quantity < req.Quantity {
-		return nil, fiber.StatusBadRequest, errors.New("stock not enough")
-	}
-
-	item.Quantity = req.Quantity
-	if err := s.itemRepository.Update(item); err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("failed to update item: " + err.Error())
-	}
-
-	updatedCart, err := s.cartRepository.GetCartByID(cart.ID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("failed to fetch updated cart: " + err.Error())
-	}
-
-	return updatedCart, fiber.StatusOK, nil
-}
-
-func (s *CartServiceImpl) DeleteItemFromCart(itemID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error) {
-	cart, err := s.cartRepository.GetCartByCondition("user_id = ? AND status = ?", userContext.ID, "pending")
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("cart not found")
-	}
-
-	item, err := s.itemRepository.GetItemByCondition("id = ?", itemID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("item not found")
-	}
-
-	if err := s.itemRepository.Delete(item); err != nil {
-		return nil, fiber.StatusInternalServerError, errors.New("failed to delete item: " + err.Error())
-	}
-
-	return cart, fiber.StatusOK, nil
-}
-
-func (s *CartServiceImpl) ConfirmCart(cartID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error) {
-	var cart models.Cart
-	if err := s.db.
-		Preload("Items", func(db *gorm.DB) *gorm.DB {
-			return db.Order("items.id")
-		}).
-		Preload("Items.Snack").
-		Where("id = ?", cartID).
-		First(&cart).Error; err != nil {
-		return nil, fiber.StatusInternalServerError, err
-	}
-
-	if cart.ID == uuid.Nil {
-		return nil, fiber.StatusBadRequest, errors.New("cart not found")
-	}
-
-	userUUID, err := uuid.Parse(userContext.ID)
-	if err != nil {
-		return nil, fiber.StatusInternalServerError, err
-	}
-
-	if cart.UserID != userUUID {
-		return nil, fiber.StatusForbidden, errors.New("cart does not belong to user")
-	}
-
-	tx := s.cartRepository.Begin()
-
-	if err := tx.Model(&models.Cart{}).Where("id = ?", cart.ID).Update("status", "confirmed").Error; err != nil {
-		tx.Rollback()
-		return nil, fiber.StatusInternalServerError, err
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return nil, fiber.StatusInternalServerError, err
-	}
-
-	cart.Status = "confirmed"
-	return &cart, fiber.StatusOK, nil
-}
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/dtos/request"
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/baimhons/nom-naa-shop.git/internal/repositories"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// stockReservationTTL bounds how long an item sitting in a pending cart
+// shows as "reserved" to other shoppers before it's treated as abandoned.
+const stockReservationTTL = 30 * time.Minute
+
+type CartService interface {
+	AddItemToCart(ctx context.Context, req request.AddItemToCartRequest, userContext models.UserContext) (*models.Cart, int, error)
+	GetCartByID(ctx context.Context, id uuid.UUID) (*models.CartBreakdown, int, error)
+	UpdateItemFromCart(ctx context.Context, req request.UpdateItemFromCartRequest, userContext models.UserContext) (*models.Cart, int, error)
+	ConfirmCart(ctx context.Context, cartID uuid.UUID, userContext models.UserContext) (*models.ConfirmCartResult, int, error)
+	DeleteItemFromCart(ctx context.Context, itemID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error)
+	ApplyPromotion(ctx context.Context, cartID uuid.UUID, code string, userContext models.UserContext) (*models.CartBreakdown, int, error)
+	RemovePromotion(ctx context.Context, cartID uuid.UUID, code string, userContext models.UserContext) (*models.CartBreakdown, int, error)
+	// MergeGuestCart folds a guest cart's items into the caller's pending
+	// cart on login/registration and deletes the guest cart. It's safe to
+	// call more than once for the same guestToken: once the guest cart is
+	// gone the call is a no-op that just returns the user's cart.
+	MergeGuestCart(ctx context.Context, guestToken string, userContext models.UserContext) (*models.Cart, int, error)
+}
+
+type CartServiceImpl struct {
+	cartRepository             repositories.CartRepository
+	snackRepository            repositories.SnackRepository
+	itemRepository             repositories.ItemRepository
+	stockReservationRepository repositories.StockReservationRepository
+	cartPromotionRepository    repositories.CartPromotionRepository
+	promotionService           PromotionService
+	orderService               OrderService
+	db                         *gorm.DB
+}
+
+func NewCartService(
+	cartRepository repositories.CartRepository,
+	snackRepository repositories.SnackRepository,
+	itemRepository repositories.ItemRepository,
+	stockReservationRepository repositories.StockReservationRepository,
+	cartPromotionRepository repositories.CartPromotionRepository,
+	promotionService PromotionService,
+	orderService OrderService,
+	db *gorm.DB,
+) *CartServiceImpl {
+	return &CartServiceImpl{
+		cartRepository:             cartRepository,
+		snackRepository:            snackRepository,
+		itemRepository:             itemRepository,
+		stockReservationRepository: stockReservationRepository,
+		cartPromotionRepository:    cartPromotionRepository,
+		promotionService:           promotionService,
+		orderService:               orderService,
+		db:                         db,
+	}
+}
+
+// pendingCartCondition keys a lookup on the caller's user_id once they're
+// logged in, falling back to the opaque guest_token a not-yet-authenticated
+// shopper carries in a cookie/header. MergeGuestCart is what reconciles the
+// two once the shopper logs in.
+func pendingCartCondition(userContext models.UserContext, guestToken string) (string, interface{}) {
+	if userContext.ID != "" {
+		return "user_id = ? AND status = ?", userContext.ID
+	}
+	return "guest_token = ? AND status = ?", guestToken
+}
+
+// createGuestCart opens the pending cart for a not-yet-authenticated
+// shopper's first AddItemToCart, keyed on the guest_token their client
+// generated and will keep sending until they log in and MergeGuestCart
+// folds it into their user cart.
+func (s *CartServiceImpl) createGuestCart(ctx context.Context, guestToken string) (*models.Cart, error) {
+	cart := models.Cart{
+		GuestToken: guestToken,
+		Status:     "pending",
+	}
+	if err := s.db.WithContext(ctx).Create(&cart).Error; err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+func (s *CartServiceImpl) AddItemToCart(ctx context.Context, req request.AddItemToCartRequest, userContext models.UserContext) (*models.Cart, int, error) {
+	condition, key := pendingCartCondition(userContext, req.GuestToken)
+	cart, err := s.cartRepository.GetCartByCondition(ctx, condition, key, "pending")
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) || userContext.ID != "" {
+			return nil, fiber.StatusInternalServerError, errors.New("cart not found")
+		}
+
+		cart, err = s.createGuestCart(ctx, req.GuestToken)
+		if err != nil {
+			return nil, fiber.StatusInternalServerError, errors.New("failed to create guest cart: " + err.Error())
+		}
+	}
+
+	snack, err := s.snackRepository.GetSnackByID(ctx, req.SnackID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("snack not found")
+	}
+
+	isExist := false
+	var existingItem *models.Item
+
+	for i := range cart.Items {
+		if cart.Items[i].SnackID == req.SnackID {
+			isExist = true
+			existingItem = &cart.Items[i]
+			break
+		}
+	}
+
+	totalReserved := req.Quantity
+	if isExist {
+		totalReserved = existingItem.Quantity + req.Quantity
+	}
+
+	reservedByOthers, err := s.stockReservationRepository.SumReservedQuantity(ctx, req.SnackID, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to check stock reservations: " + err.Error())
+	}
+
+	if snack.Quantity-reservedByOthers < totalReserved {
+		return nil, fiber.StatusBadRequest, errors.New("stock not enough")
+	}
+
+	if isExist {
+		existingItem.Quantity += req.Quantity
+		if err := s.itemRepository.Update(ctx, existingItem); err != nil {
+			return nil, fiber.StatusInternalServerError, errors.New("failed to update item: " + err.Error())
+		}
+	} else {
+		newItem := models.Item{
+			SnackID:  req.SnackID,
+			Quantity: req.Quantity,
+			CartID:   cart.ID,
+		}
+
+		if err := s.itemRepository.Update(ctx, &newItem); err != nil {
+			return nil, fiber.StatusInternalServerError, errors.New("failed to create item: " + err.Error())
+		}
+	}
+
+	updatedCart, err := s.cartRepository.GetCartByID(ctx, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to fetch updated cart: " + err.Error())
+	}
+
+	if err := s.stockReservationRepository.Reserve(ctx, cart.ID, req.SnackID, totalReserved, stockReservationTTL); err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to reserve stock: " + err.Error())
+	}
+
+	return updatedCart, fiber.StatusOK, nil
+}
+
+// hydrateSnacks loads the Snack for every item on cart in one query instead
+// of round-tripping per item, and assigns each back onto its Item.
+func (s *CartServiceImpl) hydrateSnacks(ctx context.Context, cart *models.Cart) error {
+	if len(cart.Items) == 0 {
+		return nil
+	}
+
+	snackIDs := make([]uuid.UUID, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		snackIDs = append(snackIDs, item.SnackID)
+	}
+
+	var snacks []models.Snack
+	if err := s.db.WithContext(ctx).Where("id IN ?", snackIDs).Find(&snacks).Error; err != nil {
+		return err
+	}
+
+	snackByID := make(map[uuid.UUID]models.Snack, len(snacks))
+	for _, snack := range snacks {
+		snackByID[snack.ID] = snack
+	}
+
+	for i := range cart.Items {
+		cart.Items[i].Snack = snackByID[cart.Items[i].SnackID]
+	}
+
+	return nil
+}
+
+func (s *CartServiceImpl) GetCartByID(ctx context.Context, id uuid.UUID) (*models.CartBreakdown, int, error) {
+	cart, err := s.cartRepository.GetCartByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.StatusNotFound, errors.New("cart not found")
+		}
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if err := s.hydrateSnacks(ctx, cart); err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	promotions, err := s.cartPromotionRepository.ListPromotionsByCartID(ctx, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to load applied promotions: " + err.Error())
+	}
+
+	breakdown := s.promotionService.Price(cart, promotions)
+	return &breakdown, fiber.StatusOK, nil
+}
+
+// ApplyPromotion validates the code against the cart's current subtotal and
+// attaches it, enforcing that a cart holds at most one cart-level promotion
+// and one FREE_SHIPPING promotion at a time.
+func (s *CartServiceImpl) ApplyPromotion(ctx context.Context, cartID uuid.UUID, code string, userContext models.UserContext) (*models.CartBreakdown, int, error) {
+	cart, err := s.cartRepository.GetCartByCondition(ctx, "id = ? AND user_id = ? AND status = ?", cartID, userContext.ID, "pending")
+	if err != nil {
+		return nil, fiber.StatusNotFound, errors.New("cart not found")
+	}
+
+	if err := s.hydrateSnacks(ctx, cart); err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	existing, err := s.cartPromotionRepository.ListPromotionsByCartID(ctx, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to load applied promotions: " + err.Error())
+	}
+
+	subtotal := 0.0
+	for _, item := range cart.Items {
+		subtotal += item.Snack.Price * float64(item.Quantity)
+	}
+
+	userID, err := uuid.Parse(userContext.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	promotion, err := s.promotionService.Validate(ctx, code, subtotal, time.Now(), userID)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, err
+	}
+
+	for _, applied := range existing {
+		if applied.Code == promotion.Code {
+			return nil, fiber.StatusConflict, errors.New("promotion already applied")
+		}
+		if applied.IsCartLevel() && promotion.IsCartLevel() {
+			return nil, fiber.StatusConflict, errors.New("cart already has a cart-level promotion applied")
+		}
+		if !applied.IsCartLevel() && !promotion.IsCartLevel() {
+			return nil, fiber.StatusConflict, errors.New("cart already has a shipping promotion applied")
+		}
+	}
+
+	if err := s.cartPromotionRepository.Attach(ctx, cart.ID, promotion.ID); err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to apply promotion: " + err.Error())
+	}
+
+	breakdown := s.promotionService.Price(cart, append(existing, *promotion))
+	return &breakdown, fiber.StatusOK, nil
+}
+
+func (s *CartServiceImpl) RemovePromotion(ctx context.Context, cartID uuid.UUID, code string, userContext models.UserContext) (*models.CartBreakdown, int, error) {
+	cart, err := s.cartRepository.GetCartByCondition(ctx, "id = ? AND user_id = ? AND status = ?", cartID, userContext.ID, "pending")
+	if err != nil {
+		return nil, fiber.StatusNotFound, errors.New("cart not found")
+	}
+
+	if err := s.hydrateSnacks(ctx, cart); err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	existing, err := s.cartPromotionRepository.ListPromotionsByCartID(ctx, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to load applied promotions: " + err.Error())
+	}
+
+	remaining := make([]models.Promotion, 0, len(existing))
+	var removed *models.Promotion
+	for _, applied := range existing {
+		if applied.Code == code {
+			promotion := applied
+			removed = &promotion
+			continue
+		}
+		remaining = append(remaining, applied)
+	}
+
+	if removed == nil {
+		return nil, fiber.StatusNotFound, errors.New("promotion not applied to this cart")
+	}
+
+	if err := s.cartPromotionRepository.Detach(ctx, cart.ID, removed.ID); err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to remove promotion: " + err.Error())
+	}
+
+	breakdown := s.promotionService.Price(cart, remaining)
+	return &breakdown, fiber.StatusOK, nil
+}
+
+func (s *CartServiceImpl) UpdateItemFromCart(ctx context.Context, req request.UpdateItemFromCartRequest, userContext models.UserContext) (*models.Cart, int, error) {
+	cart, err := s.cartRepository.GetCartByCondition(ctx, "user_id = ? AND status = ?", userContext.ID, "pending")
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("cart not found")
+	}
+
+	item, err := s.itemRepository.GetItemByID(ctx, req.ItemID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("item not found")
+	}
+
+	snack, err := s.snackRepository.GetSnackByID(ctx, item.SnackID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("snack not found")
+	}
+
+	reservedByOthers, err := s.stockReservationRepository.SumReservedQuantity(ctx, item.SnackID, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to check stock reservations: " + err.Error())
+	}
+
+	if snack.Quantity-reservedByOthers < req.Quantity {
+		return nil, fiber.StatusBadRequest, errors.New("stock not enough")
+	}
+
+	item.Quantity = req.Quantity
+	if err := s.itemRepository.Update(ctx, item); err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to update item: " + err.Error())
+	}
+
+	if err := s.stockReservationRepository.Reserve(ctx, cart.ID, item.SnackID, req.Quantity, stockReservationTTL); err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to reserve stock: " + err.Error())
+	}
+
+	updatedCart, err := s.cartRepository.GetCartByID(ctx, cart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to fetch updated cart: " + err.Error())
+	}
+
+	return updatedCart, fiber.StatusOK, nil
+}
+
+func (s *CartServiceImpl) DeleteItemFromCart(ctx context.Context, itemID uuid.UUID, userContext models.UserContext) (*models.Cart, int, error) {
+	cart, err := s.cartRepository.GetCartByCondition(ctx, "user_id = ? AND status = ?", userContext.ID, "pending")
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("cart not found")
+	}
+
+	item, err := s.itemRepository.GetItemByCondition(ctx, "id = ?", itemID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("item not found")
+	}
+
+	if err := s.itemRepository.Delete(ctx, item); err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to delete item: " + err.Error())
+	}
+
+	return cart, fiber.StatusOK, nil
+}
+
+// ConfirmCart locks every snack referenced by the cart, re-verifies stock
+// under that lock, and decrements it atomically so concurrent confirmations
+// can't oversell the same snack. It also freezes the cart into an Order and
+// opens a payment intent for it. Everything happens in one transaction: a
+// stock, promotion, or order-creation failure rolls the whole confirmation
+// back instead of leaving the cart half-decremented.
+func (s *CartServiceImpl) ConfirmCart(ctx context.Context, cartID uuid.UUID, userContext models.UserContext) (*models.ConfirmCartResult, int, error) {
+	var cart models.Cart
+	if err := s.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("items.id")
+		}).
+		Preload("Items.Snack").
+		Where("id = ?", cartID).
+		First(&cart).Error; err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if cart.ID == uuid.Nil {
+		return nil, fiber.StatusBadRequest, errors.New("cart not found")
+	}
+
+	userUUID, err := uuid.Parse(userContext.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if cart.UserID != userUUID {
+		return nil, fiber.StatusForbidden, errors.New("cart does not belong to user")
+	}
+
+	if len(cart.Items) == 0 {
+		return nil, fiber.StatusBadRequest, errors.New("cart is empty")
+	}
+
+	snackIDs := make([]uuid.UUID, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		snackIDs = append(snackIDs, item.SnackID)
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, fiber.StatusInternalServerError, tx.Error
+	}
+
+	var lockedSnacks []models.Snack
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", snackIDs).
+		Find(&lockedSnacks).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	snackByID := make(map[uuid.UUID]models.Snack, len(lockedSnacks))
+	for _, snack := range lockedSnacks {
+		snackByID[snack.ID] = snack
+	}
+
+	offendingSnackIDs := make([]uuid.UUID, 0)
+	for _, item := range cart.Items {
+		snack, ok := snackByID[item.SnackID]
+		if !ok || snack.Quantity < item.Quantity {
+			offendingSnackIDs = append(offendingSnackIDs, item.SnackID)
+		}
+	}
+
+	if len(offendingSnackIDs) > 0 {
+		tx.Rollback()
+		return nil, fiber.StatusConflict, fmt.Errorf("stock not enough for snacks: %v", offendingSnackIDs)
+	}
+
+	for _, item := range cart.Items {
+		result := tx.Model(&models.Snack{}).
+			Where("id = ? AND quantity >= ?", item.SnackID, item.Quantity).
+			Update("quantity", gorm.Expr("quantity - ?", item.Quantity))
+		if result.Error != nil {
+			tx.Rollback()
+			return nil, fiber.StatusInternalServerError, result.Error
+		}
+		if result.RowsAffected != 1 {
+			tx.Rollback()
+			return nil, fiber.StatusConflict, fmt.Errorf("lost the stock race for snack %s", item.SnackID)
+		}
+	}
+
+	appliedPromotions, err := s.cartPromotionRepository.ListPromotionsByCartID(ctx, cart.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusInternalServerError, errors.New("failed to load applied promotions: " + err.Error())
+	}
+
+	now := time.Now()
+	for _, promotion := range appliedPromotions {
+		if !promotion.IsActive(now) {
+			tx.Rollback()
+			return nil, fiber.StatusConflict, fmt.Errorf("promotion %s is no longer active", promotion.Code)
+		}
+	}
+
+	if err := s.promotionService.RedeemAllWithTx(tx, appliedPromotions, userUUID); err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusConflict, err
+	}
+
+	breakdown := s.promotionService.Price(&cart, appliedPromotions)
+
+	order, paymentIntent, err := s.orderService.CreateFromCartWithTx(tx, &cart, breakdown, "external")
+	if err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if err := tx.Model(&models.Cart{}).Where("id = ?", cart.ID).Update("status", "confirmed").Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if err := tx.Where("cart_id = ?", cart.ID).Delete(&models.StockReservation{}).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	cart.Status = "confirmed"
+	return &models.ConfirmCartResult{Cart: &cart, Order: order, PaymentIntent: paymentIntent}, fiber.StatusOK, nil
+}
+
+// MergeGuestCart folds guestToken's pending cart into userContext's pending
+// cart: items for a snack already in the user's cart have their quantities
+// summed (capped at the snack's current stock) and the now-redundant guest
+// item is dropped, everything else is reparented onto the user's cart, and
+// the now-empty guest cart is deleted. If the user doesn't have a pending
+// cart yet (e.g. they just registered), the guest cart is reparented onto
+// them wholesale instead, since there's nothing to merge it into. Running
+// the whole thing in one transaction, keyed off the guest cart's existence,
+// is what makes a repeated call (e.g. two tabs completing login at once) a
+// no-op the second time instead of double-counting quantities.
+func (s *CartServiceImpl) MergeGuestCart(ctx context.Context, guestToken string, userContext models.UserContext) (*models.Cart, int, error) {
+	userCart, err := s.cartRepository.GetCartByCondition(ctx, "user_id = ? AND status = ?", userContext.ID, "pending")
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.StatusInternalServerError, errors.New("cart not found")
+		}
+		userCart = nil
+	}
+
+	guestCart, err := s.cartRepository.GetCartByCondition(ctx, "guest_token = ? AND status = ?", guestToken, "pending")
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if userCart == nil {
+				return nil, fiber.StatusNotFound, errors.New("cart not found")
+			}
+			return userCart, fiber.StatusOK, nil
+		}
+		return nil, fiber.StatusInternalServerError, errors.New("failed to load guest cart: " + err.Error())
+	}
+
+	tx := s.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, fiber.StatusInternalServerError, tx.Error
+	}
+
+	if userCart == nil {
+		if err := tx.Model(&models.Cart{}).Where("id = ?", guestCart.ID).
+			Updates(map[string]interface{}{"user_id": userContext.ID, "guest_token": ""}).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.StatusInternalServerError, err
+		}
+		if err := tx.Commit().Error; err != nil {
+			return nil, fiber.StatusInternalServerError, err
+		}
+
+		mergedCart, err := s.cartRepository.GetCartByID(ctx, guestCart.ID)
+		if err != nil {
+			return nil, fiber.StatusInternalServerError, errors.New("failed to fetch merged cart: " + err.Error())
+		}
+		return mergedCart, fiber.StatusOK, nil
+	}
+
+	userItemBySnack := make(map[uuid.UUID]models.Item, len(userCart.Items))
+	for _, item := range userCart.Items {
+		userItemBySnack[item.SnackID] = item
+	}
+
+	for _, guestItem := range guestCart.Items {
+		var snack models.Snack
+		if err := tx.Where("id = ?", guestItem.SnackID).First(&snack).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.StatusInternalServerError, err
+		}
+
+		if existing, ok := userItemBySnack[guestItem.SnackID]; ok {
+			quantity := existing.Quantity + guestItem.Quantity
+			if quantity > snack.Quantity {
+				quantity = snack.Quantity
+			}
+			if err := tx.Model(&models.Item{}).Where("id = ?", existing.ID).Update("quantity", quantity).Error; err != nil {
+				tx.Rollback()
+				return nil, fiber.StatusInternalServerError, err
+			}
+			if err := tx.Where("id = ?", guestItem.ID).Delete(&models.Item{}).Error; err != nil {
+				tx.Rollback()
+				return nil, fiber.StatusInternalServerError, err
+			}
+		} else {
+			if err := tx.Model(&models.Item{}).Where("id = ?", guestItem.ID).Update("cart_id", userCart.ID).Error; err != nil {
+				tx.Rollback()
+				return nil, fiber.StatusInternalServerError, err
+			}
+		}
+	}
+
+	if err := tx.Where("id = ?", guestCart.ID).Delete(&models.Cart{}).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.StatusInternalServerError, err
+	}
+
+	mergedCart, err := s.cartRepository.GetCartByID(ctx, userCart.ID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, errors.New("failed to fetch merged cart: " + err.Error())
+	}
+
+	return mergedCart, fiber.StatusOK, nil
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/baimhons/nom-naa-shop.git/internal/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidOrderTransition = errors.New("invalid order status transition")
+
+// OrderService owns the order/payment lifecycle once a cart is confirmed:
+// PENDING_PAYMENT -> PAID -> FULFILLED -> COMPLETED, with CANCELLED and
+// REFUNDED branches off the states that allow them. Every transition is a
+// conditional update keyed on the expected current status, so a retried or
+// out-of-order webhook delivery can't replay a transition twice.
+type OrderService interface {
+	// CreateFromCartWithTx freezes the cart's items and the promotion
+	// breakdown onto a new PENDING_PAYMENT Order, and opens a PENDING payment
+	// intent for it, inside the caller's stock-decrementing transaction.
+	CreateFromCartWithTx(tx *gorm.DB, cart *models.Cart, breakdown models.CartBreakdown, provider string) (*models.Order, *models.Payment, error)
+	MarkPaid(ctx context.Context, idempotencyKey string) (*models.Order, error)
+	Cancel(ctx context.Context, orderID uuid.UUID) (*models.Order, error)
+	Refund(ctx context.Context, orderID uuid.UUID) (*models.Order, error)
+}
+
+type OrderServiceImpl struct {
+	orderRepository   repositories.OrderRepository
+	paymentRepository repositories.PaymentRepository
+	snackRepository   repositories.SnackRepository
+	db                *gorm.DB
+}
+
+func NewOrderService(
+	orderRepository repositories.OrderRepository,
+	paymentRepository repositories.PaymentRepository,
+	snackRepository repositories.SnackRepository,
+	db *gorm.DB,
+) *OrderServiceImpl {
+	return &OrderServiceImpl{
+		orderRepository:   orderRepository,
+		paymentRepository: paymentRepository,
+		snackRepository:   snackRepository,
+		db:                db,
+	}
+}
+
+func (s *OrderServiceImpl) CreateFromCartWithTx(tx *gorm.DB, cart *models.Cart, breakdown models.CartBreakdown, provider string) (*models.Order, *models.Payment, error) {
+	order := &models.Order{
+		CartID:   cart.ID,
+		UserID:   cart.UserID,
+		Status:   models.OrderStatusPendingPayment,
+		Subtotal: breakdown.Subtotal,
+		Discount: breakdown.Subtotal - breakdown.Total,
+		Total:    breakdown.Total,
+	}
+
+	for _, item := range cart.Items {
+		order.Items = append(order.Items, models.OrderItem{
+			SnackID:   item.SnackID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.Snack.Price,
+		})
+	}
+
+	if err := s.orderRepository.CreateWithTx(tx, order); err != nil {
+		return nil, nil, fmt.Errorf("failed to create order: %w", err)
+	}
+
+	payment := &models.Payment{
+		OrderID:        order.ID,
+		Provider:       provider,
+		IdempotencyKey: uuid.NewString(),
+		Amount:         order.Total,
+		Status:         models.PaymentStatusPending,
+	}
+
+	if err := s.paymentRepository.CreateWithTx(tx, payment); err != nil {
+		return nil, nil, fmt.Errorf("failed to open payment intent: %w", err)
+	}
+
+	return order, payment, nil
+}
+
+// MarkPaid is the webhook entry point: it looks the payment up by the
+// idempotency key the provider echoes back, and drives PENDING_PAYMENT ->
+// PAID. A duplicate delivery, or one that arrives after the order already
+// moved on, finds no PENDING_PAYMENT order to update and returns
+// ErrInvalidOrderTransition instead of silently double-processing.
+func (s *OrderServiceImpl) MarkPaid(ctx context.Context, idempotencyKey string) (*models.Order, error) {
+	payment, err := s.paymentRepository.GetByIdempotencyKey(ctx, idempotencyKey)
+	if err != nil {
+		return nil, fmt.Errorf("payment not found: %w", err)
+	}
+
+	return s.transition(ctx, payment.OrderID, models.OrderStatusPendingPayment, models.OrderStatusPaid, func(tx *gorm.DB) error {
+		return tx.Model(&models.Payment{}).Where("id = ?", payment.ID).Update("status", models.PaymentStatusSucceeded).Error
+	})
+}
+
+// Cancel moves an order to CANCELLED and restocks every item it held,
+// undoing the decrement ConfirmCart made when the order was created.
+func (s *OrderServiceImpl) Cancel(ctx context.Context, orderID uuid.UUID) (*models.Order, error) {
+	order, err := s.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("order not found: %w", err)
+	}
+
+	if !order.Status.CanTransitionTo(models.OrderStatusCancelled) {
+		return nil, fmt.Errorf("%w: cannot cancel an order in %s", ErrInvalidOrderTransition, order.Status)
+	}
+
+	return s.transition(ctx, orderID, order.Status, models.OrderStatusCancelled, func(tx *gorm.DB) error {
+		for _, item := range order.Items {
+			if err := tx.Model(&models.Snack{}).
+				Where("id = ?", item.SnackID).
+				Update("quantity", gorm.Expr("quantity + ?", item.Quantity)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *OrderServiceImpl) Refund(ctx context.Context, orderID uuid.UUID) (*models.Order, error) {
+	order, err := s.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("order not found: %w", err)
+	}
+
+	if !order.Status.CanTransitionTo(models.OrderStatusRefunded) {
+		return nil, fmt.Errorf("%w: cannot refund an order in %s", ErrInvalidOrderTransition, order.Status)
+	}
+
+	return s.transition(ctx, orderID, order.Status, models.OrderStatusRefunded, func(tx *gorm.DB) error {
+		return tx.Model(&models.Payment{}).Where("order_id = ?", orderID).Update("status", models.PaymentStatusRefunded).Error
+	})
+}
+
+// transition runs a conditional status update plus a side effect (restock,
+// payment status flip, ...) in one transaction, and reloads the order on
+// success.
+func (s *OrderServiceImpl) transition(ctx context.Context, orderID uuid.UUID, from, next models.OrderStatus, sideEffect func(tx *gorm.DB) error) (*models.Order, error) {
+	tx := s.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	moved, err := s.orderRepository.UpdateStatusWithTx(tx, orderID, from, next)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !moved {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: order is no longer in %s", ErrInvalidOrderTransition, from)
+	}
+
+	if err := sideEffect(tx); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return s.orderRepository.GetByID(ctx, orderID)
+}
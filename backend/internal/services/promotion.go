@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/baimhons/nom-naa-shop.git/internal/models"
+	"github.com/baimhons/nom-naa-shop.git/internal/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrPerUserLimitExceeded is returned by Validate and RedeemAllWithTx when
+// userID has already redeemed a promotion PerUserLimit times.
+var ErrPerUserLimitExceeded = errors.New("promotion per-user redemption limit exceeded")
+
+// PromotionService owns promotion validation and pricing so that neither
+// the handlers nor CartService need to know the rules for a given
+// PromotionType. CartService calls into it to validate a code before
+// attaching it to a cart, to price a cart for display, and to redeem every
+// attached promotion atomically alongside the stock decrement.
+type PromotionService interface {
+	Validate(ctx context.Context, code string, subtotal float64, now time.Time, userID uuid.UUID) (*models.Promotion, error)
+	Price(cart *models.Cart, promotions []models.Promotion) models.CartBreakdown
+	RedeemAllWithTx(tx *gorm.DB, promotions []models.Promotion, userID uuid.UUID) error
+}
+
+type PromotionServiceImpl struct {
+	promotionRepository           repositories.PromotionRepository
+	promotionRedemptionRepository repositories.PromotionRedemptionRepository
+}
+
+func NewPromotionService(promotionRepository repositories.PromotionRepository, promotionRedemptionRepository repositories.PromotionRedemptionRepository) *PromotionServiceImpl {
+	return &PromotionServiceImpl{
+		promotionRepository:           promotionRepository,
+		promotionRedemptionRepository: promotionRedemptionRepository,
+	}
+}
+
+func (s *PromotionServiceImpl) Validate(ctx context.Context, code string, subtotal float64, now time.Time, userID uuid.UUID) (*models.Promotion, error) {
+	promotion, err := s.promotionRepository.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !promotion.IsActive(now) {
+		return nil, errors.New("promotion is not active")
+	}
+
+	if subtotal < promotion.MinSubtotal {
+		return nil, errors.New("cart subtotal does not meet the promotion minimum")
+	}
+
+	if promotion.Type == models.PromotionTypeBuyXGetY && (promotion.BuyQuantity <= 0 || promotion.GetQuantity <= 0) {
+		return nil, errors.New("promotion is misconfigured: BUY_X_GET_Y requires a positive buy and get quantity")
+	}
+
+	if promotion.PerUserLimit > 0 {
+		redeemed, err := s.promotionRedemptionRepository.CountByUser(ctx, promotion.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if redeemed >= promotion.PerUserLimit {
+			return nil, ErrPerUserLimitExceeded
+		}
+	}
+
+	return promotion, nil
+}
+
+// Price computes the subtotal, the discount each promotion contributes (in
+// the order the promotions were applied), and the resulting total. It
+// assumes the caller has already enforced stacking rules, so it simply
+// applies every promotion it's given.
+func (s *PromotionServiceImpl) Price(cart *models.Cart, promotions []models.Promotion) models.CartBreakdown {
+	subtotal := 0.0
+	for _, item := range cart.Items {
+		subtotal += item.Snack.Price * float64(item.Quantity)
+	}
+
+	remaining := subtotal
+	discounts := make([]models.PromotionDiscount, 0, len(promotions))
+	for _, promotion := range promotions {
+		amount := s.discountAmount(promotion, cart, subtotal)
+		if amount > remaining {
+			amount = remaining
+		}
+		remaining -= amount
+
+		discounts = append(discounts, models.PromotionDiscount{
+			PromotionID: promotion.ID.String(),
+			Code:        promotion.Code,
+			Type:        string(promotion.Type),
+			Amount:      amount,
+		})
+	}
+
+	return models.CartBreakdown{
+		Cart:      cart,
+		Subtotal:  subtotal,
+		Discounts: discounts,
+		Total:     remaining,
+	}
+}
+
+func (s *PromotionServiceImpl) discountAmount(promotion models.Promotion, cart *models.Cart, subtotal float64) float64 {
+	switch promotion.Type {
+	case models.PromotionTypePercentOff:
+		return subtotal * promotion.Value / 100
+	case models.PromotionTypeFixedOff:
+		return promotion.Value
+	case models.PromotionTypeFreeShipping:
+		// Shipping isn't priced into the cart subtotal, so it has no
+		// subtotal discount of its own — it's still returned in the
+		// breakdown so the client can show it as applied.
+		return 0
+	case models.PromotionTypeBuyXGetY:
+		return s.buyXGetYDiscount(cart, promotion)
+	default:
+		return 0
+	}
+}
+
+// buyXGetYDiscount prices a BUY_X_GET_Y promotion by expanding every item
+// line into one entry per unit, sorting ascending by price, and giving away
+// the cheapest GetQuantity units in every full group of
+// BuyQuantity+GetQuantity units — so within each group the discount lands on
+// its least expensive units rather than a flat Value. Groups are taken in
+// ascending price order, and leftover units that don't fill a group are
+// never free.
+func (s *PromotionServiceImpl) buyXGetYDiscount(cart *models.Cart, promotion models.Promotion) float64 {
+	groupSize := promotion.BuyQuantity + promotion.GetQuantity
+	if groupSize <= 0 {
+		return 0
+	}
+
+	unitPrices := make([]float64, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		for i := 0; i < item.Quantity; i++ {
+			unitPrices = append(unitPrices, item.Snack.Price)
+		}
+	}
+	sort.Float64s(unitPrices)
+
+	discount := 0.0
+	for i := 0; i+groupSize <= len(unitPrices); i += groupSize {
+		for j := 0; j < promotion.GetQuantity; j++ {
+			discount += unitPrices[i+j]
+		}
+	}
+	return discount
+}
+
+func (s *PromotionServiceImpl) RedeemAllWithTx(tx *gorm.DB, promotions []models.Promotion, userID uuid.UUID) error {
+	for _, promotion := range promotions {
+		// RedeemWithTx's conditional UPDATE takes a row lock on this
+		// promotion that's held until tx commits/rolls back, so it also
+		// serializes the count-then-insert below against any other
+		// transaction redeeming the same promotion concurrently.
+		if err := s.promotionRepository.RedeemWithTx(tx, promotion.ID); err != nil {
+			return err
+		}
+
+		if promotion.PerUserLimit > 0 {
+			redeemed, err := s.promotionRedemptionRepository.CountByUserWithTx(tx, promotion.ID, userID)
+			if err != nil {
+				return err
+			}
+			if redeemed >= promotion.PerUserLimit {
+				return fmt.Errorf("%w: %s", ErrPerUserLimitExceeded, promotion.Code)
+			}
+		}
+
+		if err := s.promotionRedemptionRepository.RecordWithTx(tx, promotion.ID, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}